@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/google/go-github/v45/github"
+	"gopkg.in/yaml.v3"
+)
+
+// Duration lets config.yaml express durations as strings ("15m", "24h")
+// instead of raw nanosecond integers.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// OrgConfig describes one GitHub org this server serves stats/redirects for.
+type OrgConfig struct {
+	Name           string   `yaml:"name"`
+	DisplayName    string   `yaml:"display_name"`
+	IncludePrivate bool     `yaml:"include_private"`
+	ExcludeRepos   []string `yaml:"exclude_repos"`
+	IncludeForks   bool     `yaml:"include_forks"`
+	CacheTTL       Duration `yaml:"cache_ttl"`
+}
+
+// cacheTTL resolves CacheTTL to cacheDuration when the config leaves it
+// unset, so orgs don't have to repeat the default in config.yaml.
+func (o OrgConfig) cacheTTL() time.Duration {
+	if o.CacheTTL == 0 {
+		return cacheDuration
+	}
+	return time.Duration(o.CacheTTL)
+}
+
+// excludesRepo reports whether repo is in this org's exclude_repos list.
+func (o OrgConfig) excludesRepo(repo string) bool {
+	for _, excluded := range o.ExcludeRepos {
+		if excluded == repo {
+			return true
+		}
+	}
+	return false
+}
+
+// Config is the top-level shape of config.yaml.
+type Config struct {
+	DefaultOrg string      `yaml:"default_org"`
+	Orgs       []OrgConfig `yaml:"orgs"`
+}
+
+func loadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.DefaultOrg == "" && len(cfg.Orgs) > 0 {
+		cfg.DefaultOrg = cfg.Orgs[0].Name
+	}
+
+	return &cfg, nil
+}
+
+// repoAllowed applies an org's include_private/include_forks/exclude_repos
+// settings to a single repo.
+func repoAllowed(cfg OrgConfig, repo *github.Repository) bool {
+	if repo.GetPrivate() && !cfg.IncludePrivate {
+		return false
+	}
+	if repo.GetFork() && !cfg.IncludeForks {
+		return false
+	}
+	if cfg.excludesRepo(repo.GetName()) {
+		return false
+	}
+	return true
+}
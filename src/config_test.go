@@ -0,0 +1,106 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v45/github"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDurationUnmarshalYAML(t *testing.T) {
+	var d Duration
+	if err := yaml.Unmarshal([]byte(`"15m"`), &d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if time.Duration(d) != 15*time.Minute {
+		t.Fatalf("got %v, want 15m", time.Duration(d))
+	}
+
+	if err := yaml.Unmarshal([]byte(`"not-a-duration"`), &d); err == nil {
+		t.Fatal("expected an error for an invalid duration string")
+	}
+}
+
+func TestOrgConfigCacheTTL(t *testing.T) {
+	withDefault := OrgConfig{}
+	if got := withDefault.cacheTTL(); got != cacheDuration {
+		t.Errorf("cacheTTL() with no override = %v, want %v (cacheDuration)", got, cacheDuration)
+	}
+
+	withOverride := OrgConfig{CacheTTL: Duration(30 * time.Minute)}
+	if got := withOverride.cacheTTL(); got != 30*time.Minute {
+		t.Errorf("cacheTTL() with override = %v, want 30m", got)
+	}
+}
+
+func TestOrgConfigExcludesRepo(t *testing.T) {
+	cfg := OrgConfig{ExcludeRepos: []string{"secret-repo", "archived-repo"}}
+
+	if !cfg.excludesRepo("secret-repo") {
+		t.Error("expected secret-repo to be excluded")
+	}
+	if cfg.excludesRepo("public-repo") {
+		t.Error("expected public-repo to not be excluded")
+	}
+}
+
+func TestRepoAllowed(t *testing.T) {
+	repo := func(name string, private, fork bool) *github.Repository {
+		return &github.Repository{
+			Name:    github.String(name),
+			Private: github.Bool(private),
+			Fork:    github.Bool(fork),
+		}
+	}
+
+	tests := []struct {
+		name string
+		cfg  OrgConfig
+		repo *github.Repository
+		want bool
+	}{
+		{"public repo allowed by default", OrgConfig{}, repo("app", false, false), true},
+		{"private repo excluded by default", OrgConfig{}, repo("app", true, false), false},
+		{"private repo allowed when configured", OrgConfig{IncludePrivate: true}, repo("app", true, false), true},
+		{"fork excluded by default", OrgConfig{}, repo("app", false, true), false},
+		{"fork allowed when configured", OrgConfig{IncludeForks: true}, repo("app", false, true), true},
+		{"explicitly excluded repo", OrgConfig{ExcludeRepos: []string{"app"}}, repo("app", false, false), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := repoAllowed(tt.cfg, tt.repo); got != tt.want {
+				t.Errorf("repoAllowed(%+v, %q) = %v, want %v", tt.cfg, tt.repo.GetName(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfigDefaultsDefaultOrgToFirstOrg(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	data := []byte("orgs:\n  - name: VTubersTV\n    cache_ttl: 15m\n")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DefaultOrg != "VTubersTV" {
+		t.Errorf("DefaultOrg = %q, want %q", cfg.DefaultOrg, "VTubersTV")
+	}
+	if len(cfg.Orgs) != 1 || cfg.Orgs[0].CacheTTL != Duration(15*time.Minute) {
+		t.Errorf("unexpected parsed org config: %+v", cfg.Orgs)
+	}
+}
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	if _, err := loadConfig(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Fatal("expected an error for a missing config file")
+	}
+}
@@ -0,0 +1,34 @@
+// Package logger wraps zerolog so the rest of the server can emit
+// structured, filterable logs instead of ad-hoc log.Printf calls.
+package logger
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+var base = newBase()
+
+func newBase() zerolog.Logger {
+	var writer = os.Stdout
+	logger := zerolog.New(writer).With().Timestamp().Logger()
+
+	if !strings.EqualFold(os.Getenv("LOG_FORMAT"), "json") {
+		logger = zerolog.New(zerolog.ConsoleWriter{Out: writer, TimeFormat: time.RFC3339}).With().Timestamp().Logger()
+	}
+
+	return logger
+}
+
+// CreateSubLogger returns a logger with the given key/value pairs attached
+// as fields, e.g. CreateSubLogger("stage", "stats", "repo", "git-server").
+func CreateSubLogger(kv ...string) zerolog.Logger {
+	ctx := base.With()
+	for i := 0; i+1 < len(kv); i += 2 {
+		ctx = ctx.Str(kv[i], kv[i+1])
+	}
+	return ctx.Logger()
+}
@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var requestCounter uint64
+
+func nextRequestID() string {
+	n := atomic.AddUint64(&requestCounter, 1)
+	return fmt.Sprintf("%d-%d", time.Now().UnixNano(), n)
+}
+
+// Middleware attaches a per-request sublogger (request_id, method, path) to
+// the gin context under "log", then logs the outcome (status, latency_ms)
+// once the handler returns.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		sub := CreateSubLogger(
+			"request_id", nextRequestID(),
+			"method", c.Request.Method,
+			"path", c.FullPath(),
+		)
+		c.Set("log", sub)
+
+		c.Next()
+
+		sub.Info().
+			Int("status", c.Writer.Status()).
+			Int64("latency_ms", time.Since(start).Milliseconds()).
+			Msg("request")
+	}
+}
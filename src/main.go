@@ -2,42 +2,53 @@ package main
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/go-github/v45/github"
 	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
 
 	"git.vtubers.tv/src/colors"
+	"git.vtubers.tv/src/logger"
 	"github.com/joho/godotenv"
 )
 
 const (
-	githubBaseURL = "https://github.com/VTubersTV/"
+	// cacheDuration is the fallback TTL for orgs that leave cache_ttl unset
+	// in config.yaml.
 	cacheDuration = 15 * time.Minute
+	perPage       = 100
+	maxRetries    = 5
 )
 
+// orgURL is the GitHub URL an org's repos redirect to.
+func orgURL(org string) string {
+	return "https://github.com/" + org + "/"
+}
+
 type RepoStats struct {
-	Name          string    `json:"name"`
-	Stars         int       `json:"stars"`
-	Forks         int       `json:"forks"`
-	Contributors  int       `json:"contributors"`
-	Commits       int       `json:"commits"`
-	License       string    `json:"license"`
-	LastUpdated   time.Time `json:"last_updated"`
-	Description   string    `json:"description"`
-	Language      string    `json:"language"`
-	LanguageColor string    `json:"language_color"`
-	OpenIssues    int       `json:"open_issues"`
-	DefaultBranch string    `json:"default_branch"`
-	Tags          []string  `json:"topics"`
+	Name          string     `json:"name"`
+	Stars         int        `json:"stars"`
+	Forks         int        `json:"forks"`
+	Contributors  int        `json:"contributors"`
+	Commits       int        `json:"commits"`
+	License       string     `json:"license"`
+	LastUpdated   time.Time  `json:"last_updated"`
+	Description   string     `json:"description"`
+	Language      string     `json:"language"`
+	LanguageColor string     `json:"language_color"`
+	OpenIssues    int        `json:"open_issues"`
+	DefaultBranch string     `json:"default_branch"`
+	Tags          []string   `json:"topics"`
+	Scorecard     *Scorecard `json:"scorecard,omitempty"`
 }
 
 type ContributorStats struct {
@@ -47,140 +58,261 @@ type ContributorStats struct {
 	Repositories  []string `json:"repositories"`
 }
 
-type CacheItem struct {
-	Data      interface{}
-	Timestamp time.Time
-}
+// fetchGroup coalesces concurrent cold requests for the same endpoint into a
+// single upstream fetch, so N simultaneous /stats (or /contributors) calls
+// right after the cache expires don't each trigger their own GitHub calls.
+var fetchGroup singleflight.Group
+
+// withRateLimitBackoff runs fn, retrying with exponential backoff whenever
+// GitHub responds with a rate-limit error, honoring the X-RateLimit-Reset
+// header it reports rather than guessing at a sleep duration.
+func withRateLimitBackoff(fn func() (*github.Response, error)) (*github.Response, error) {
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		resp, err := fn()
+		if err == nil {
+			return resp, nil
+		}
 
-type Cache struct {
-	stats        CacheItem
-	contributors CacheItem
-	mu           sync.RWMutex
+		rateLimitErr, ok := err.(*github.RateLimitError)
+		if !ok || attempt >= maxRetries {
+			return resp, err
+		}
+
+		wait := time.Until(rateLimitErr.Rate.Reset.Time)
+		if wait <= 0 {
+			wait = backoff
+		}
+		sub := logger.CreateSubLogger("stage", "github")
+		sub.Info().Dur("wait", wait).Int("attempt", attempt+1).Int("max_retries", maxRetries).Msg("rate limited by GitHub, sleeping before retry")
+		time.Sleep(wait)
+		backoff *= 2
+	}
 }
 
-var cache = &Cache{}
+// listAllRepos follows Response.NextPage until exhausted, applying a
+// conditional If-None-Match header from a previous ETag so an unchanged org
+// repo list short-circuits to a 304 without paying for a full page fetch.
+func listAllRepos(ctx context.Context, client *github.Client, org, etag string) ([]*github.Repository, EndpointMeta, bool, error) {
+	opts := &github.RepositoryListByOrgOptions{
+		Type:        "all",
+		ListOptions: github.ListOptions{PerPage: perPage},
+	}
+
+	var allRepos []*github.Repository
+	meta := EndpointMeta{ETag: etag}
+	for page := 1; ; {
+		req, err := client.NewRequest("GET", reposPath(org, opts), nil)
+		if err != nil {
+			return nil, meta, false, err
+		}
+		if page == 1 && etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
 
-func (c *Cache) isStale(item *CacheItem) bool {
-	return time.Since(item.Timestamp) > cacheDuration
-}
+		var repos []*github.Repository
+		resp, err := withRateLimitBackoff(func() (*github.Response, error) {
+			return client.Do(ctx, req, &repos)
+		})
+		// go-github's CheckResponse turns every non-2xx/202 status, including
+		// 304, into a non-nil error, so the not-modified check has to run
+		// before the err check below treats a 304 as a failed request.
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return nil, meta, true, nil
+		}
+		if err != nil {
+			return nil, meta, false, err
+		}
+
+		// Only page 1 is sent If-None-Match, so only its ETag is kept.
+		if page == 1 {
+			meta.ETag = resp.Header.Get("ETag")
+		}
 
-func (c *Cache) updateStats(stats []RepoStats) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.stats = CacheItem{
-		Data:      stats,
-		Timestamp: time.Now(),
+		allRepos = append(allRepos, repos...)
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+		opts.Page = page
 	}
+
+	return allRepos, meta, false, nil
 }
 
-func (c *Cache) getStats() ([]RepoStats, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	if c.stats.Data == nil {
-		return nil, false
+func reposPath(org string, opts *github.RepositoryListByOrgOptions) string {
+	q := url.Values{}
+	q.Set("type", opts.Type)
+	q.Set("per_page", strconv.Itoa(opts.PerPage))
+	if opts.Page > 0 {
+		q.Set("page", strconv.Itoa(opts.Page))
 	}
-	return c.stats.Data.([]RepoStats), true
+	return "orgs/" + org + "/repos?" + q.Encode()
 }
 
-func (c *Cache) updateContributors(contributors []ContributorStats) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.contributors = CacheItem{
-		Data:      contributors,
-		Timestamp: time.Now(),
+func listAllContributors(ctx context.Context, client *github.Client, org, repo string) ([]*github.Contributor, error) {
+	opts := &github.ListContributorsOptions{
+		ListOptions: github.ListOptions{PerPage: perPage},
 	}
+
+	var all []*github.Contributor
+	for {
+		var contributors []*github.Contributor
+		resp, err := withRateLimitBackoff(func() (*github.Response, error) {
+			var innerResp *github.Response
+			var innerErr error
+			contributors, innerResp, innerErr = client.Repositories.ListContributors(ctx, org, repo, opts)
+			return innerResp, innerErr
+		})
+		if err != nil {
+			return all, err
+		}
+		all = append(all, contributors...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return all, nil
 }
 
-func (c *Cache) getContributors() ([]ContributorStats, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	if c.contributors.Data == nil {
-		return nil, false
+func listAllCommits(ctx context.Context, client *github.Client, org, repo string) (int, error) {
+	opts := &github.CommitsListOptions{
+		ListOptions: github.ListOptions{PerPage: perPage},
+	}
+
+	total := 0
+	for {
+		var commits []*github.RepositoryCommit
+		resp, err := withRateLimitBackoff(func() (*github.Response, error) {
+			var innerResp *github.Response
+			var innerErr error
+			commits, innerResp, innerErr = client.Repositories.ListCommits(ctx, org, repo, opts)
+			return innerResp, innerErr
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(commits)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
 	}
-	return c.contributors.Data.([]ContributorStats), true
+	return total, nil
 }
 
-func fetchStats(client *github.Client) ([]RepoStats, error) {
+func fetchStats(client *github.Client, org string, cfg OrgConfig, prevMeta EndpointMeta) ([]RepoStats, EndpointMeta, bool, error) {
 	ctx := context.Background()
-	repos, _, err := client.Repositories.ListByOrg(ctx, "VTubersTV", &github.RepositoryListByOrgOptions{
-		Type: "all",
-	})
+
+	repos, meta, notModified, err := listAllRepos(ctx, client, org, prevMeta.ETag)
 	if err != nil {
-		return nil, err
+		return nil, prevMeta, false, err
+	}
+	if notModified {
+		return nil, prevMeta, true, nil
 	}
 
 	var allStats []RepoStats
 	for _, repo := range repos {
-		// Skip private repositories
-		if repo.GetPrivate() {
+		if !repoAllowed(cfg, repo) {
 			continue
 		}
 
-		// Get contributors count
-		contributors, _, err := client.Repositories.ListContributors(ctx, "VTubersTV", *repo.Name, &github.ListContributorsOptions{})
-		if err != nil {
-			log.Printf("Error getting contributors for %s: %v", *repo.Name, err)
-		}
+		allStats = append(allStats, buildRepoStats(ctx, client, org, repo))
+	}
 
-		// Get commit count
-		commits, _, err := client.Repositories.ListCommits(ctx, "VTubersTV", *repo.Name, &github.CommitsListOptions{})
-		if err != nil {
-			log.Printf("Error getting commits for %s: %v", *repo.Name, err)
-		}
+	return allStats, meta, false, nil
+}
 
-		// Get tags
-		tags, _, err := client.Repositories.ListAllTopics(ctx, "VTubersTV", *repo.Name)
-		if err != nil {
-			log.Printf("Error getting tags for %s: %v", *repo.Name, err)
-		}
+// buildRepoStats assembles a RepoStats for a repo that's already been
+// fetched (e.g. from listAllRepos or a single Repositories.Get), pulling in
+// contributors, commits, and topics.
+func buildRepoStats(ctx context.Context, client *github.Client, org string, repo *github.Repository) RepoStats {
+	name := repo.GetName()
+	sub := logger.CreateSubLogger("stage", "stats", "org", org, "repo", name)
+
+	// Get contributors count, following pagination to the last page
+	contributors, err := listAllContributors(ctx, client, org, name)
+	if err != nil {
+		sub.Err(err).Msg("list contributors failed")
+	}
 
-		// Convert tags to strings
-		tagNames := make([]string, len(tags))
-		copy(tagNames, tags)
+	// Get commit count across every page of commits
+	commitCount, err := listAllCommits(ctx, client, org, name)
+	if err != nil {
+		sub.Err(err).Msg("list commits failed")
+	}
 
-		language := repo.GetLanguage()
-		stats := RepoStats{
-			Name:          *repo.Name,
-			Stars:         *repo.StargazersCount,
-			Forks:         *repo.ForksCount,
-			Contributors:  len(contributors),
-			Commits:       len(commits),
-			License:       repo.GetLicense().GetName(),
-			LastUpdated:   repo.GetUpdatedAt().Time,
-			Description:   repo.GetDescription(),
-			Language:      language,
-			LanguageColor: colors.GetLanguageColor(language),
-			OpenIssues:    repo.GetOpenIssuesCount(),
-			DefaultBranch: repo.GetDefaultBranch(),
-			Tags:          tagNames,
-		}
-		allStats = append(allStats, stats)
+	// Get tags
+	tags, _, err := client.Repositories.ListAllTopics(ctx, org, name)
+	if err != nil {
+		sub.Err(err).Msg("list topics failed")
+	}
+
+	// Convert tags to strings
+	tagNames := make([]string, len(tags))
+	copy(tagNames, tags)
+
+	language := repo.GetLanguage()
+	stats := RepoStats{
+		Name:          name,
+		Stars:         repo.GetStargazersCount(),
+		Forks:         repo.GetForksCount(),
+		Contributors:  len(contributors),
+		Commits:       commitCount,
+		License:       repo.GetLicense().GetName(),
+		LastUpdated:   repo.GetUpdatedAt().Time,
+		Description:   repo.GetDescription(),
+		Language:      language,
+		LanguageColor: colors.GetLanguageColor(language),
+		OpenIssues:    repo.GetOpenIssuesCount(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		Tags:          tagNames,
+	}
+
+	// Scorecard results come from a separate daily-refreshed cache rather
+	// than being computed inline here; attach the last known result if any.
+	if sc, ok := scorecards.get(org + "/" + name); ok {
+		stats.Scorecard = &sc
 	}
 
-	return allStats, nil
+	return stats
 }
 
-func fetchTopContributors(client *github.Client, limit int) ([]ContributorStats, error) {
+// fetchRepoStats refreshes stats for a single repo, used when a webhook
+// tells us just one repo changed rather than the whole org.
+func fetchRepoStats(ctx context.Context, client *github.Client, org, name string) (RepoStats, error) {
+	repo, _, err := client.Repositories.Get(ctx, org, name)
+	if err != nil {
+		return RepoStats{}, err
+	}
+	return buildRepoStats(ctx, client, org, repo), nil
+}
+
+func fetchTopContributors(client *github.Client, org string, cfg OrgConfig, limit int, prevMeta EndpointMeta) ([]ContributorStats, EndpointMeta, bool, error) {
 	ctx := context.Background()
-	repos, _, err := client.Repositories.ListByOrg(ctx, "VTubersTV", &github.RepositoryListByOrgOptions{
-		Type: "all",
-	})
+
+	repos, meta, notModified, err := listAllRepos(ctx, client, org, prevMeta.ETag)
 	if err != nil {
-		return nil, err
+		return nil, prevMeta, false, err
+	}
+	if notModified {
+		return nil, prevMeta, true, nil
 	}
 
 	// Map to store unique contributors and their total contributions
 	contributorMap := make(map[string]*ContributorStats)
 
 	for _, repo := range repos {
-		// Skip private repositories
-		if repo.GetPrivate() {
+		if !repoAllowed(cfg, repo) {
 			continue
 		}
 
-		contributors, _, err := client.Repositories.ListContributors(ctx, "VTubersTV", *repo.Name, &github.ListContributorsOptions{})
+		contributors, err := listAllContributors(ctx, client, org, *repo.Name)
 		if err != nil {
-			log.Printf("Error getting contributors for %s: %v", *repo.Name, err)
+			sub := logger.CreateSubLogger("stage", "contributors", "org", org, "repo", *repo.Name)
+			sub.Err(err).Msg("list contributors failed")
 			continue
 		}
 
@@ -219,35 +351,155 @@ func fetchTopContributors(client *github.Client, limit int) ([]ContributorStats,
 		contributors = contributors[:limit]
 	}
 
-	return contributors, nil
+	return contributors, meta, false, nil
 }
 
-func prefetchData(client *github.Client) {
-	// Prefetch stats
-	go func() {
-		stats, err := fetchStats(client)
+// refreshStats returns the cached stats for org if still fresh, otherwise
+// fetches (honoring the cached ETag) and updates the store. Concurrent
+// callers are coalesced via fetchGroup into a single upstream fetch.
+func refreshStats(client *github.Client, org string) ([]RepoStats, error) {
+	cfg, store, ok := registry.get(org)
+	if !ok {
+		return nil, fmt.Errorf("org %q is not configured", org)
+	}
+
+	v, err, _ := fetchGroup.Do("stats:"+org, func() (interface{}, error) {
+		existing, meta, fresh := store.GetStats()
+		if fresh {
+			return existing, nil
+		}
+
+		newStats, newMeta, notModified, err := fetchStats(client, org, cfg, meta)
 		if err != nil {
-			log.Printf("Error prefetching stats: %v", err)
-			return
+			return nil, err
+		}
+		if notModified {
+			store.SetStats(existing, newMeta)
+			return existing, nil
+		}
+		store.SetStats(newStats, newMeta)
+		return newStats, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]RepoStats), nil
+}
+
+// refreshContributors is refreshStats' counterpart for the contributors
+// endpoint; see refreshStats for the caching/coalescing behavior.
+func refreshContributors(client *github.Client, org string) ([]ContributorStats, error) {
+	cfg, store, ok := registry.get(org)
+	if !ok {
+		return nil, fmt.Errorf("org %q is not configured", org)
+	}
+
+	v, err, _ := fetchGroup.Do("contributors:"+org, func() (interface{}, error) {
+		existing, meta, fresh := store.GetContributors()
+		if fresh {
+			return existing, nil
+		}
+
+		newContributors, newMeta, notModified, err := fetchTopContributors(client, org, cfg, 0, meta)
+		if err != nil {
+			return nil, err
+		}
+		if notModified {
+			store.SetContributors(existing, newMeta)
+			return existing, nil
+		}
+		store.SetContributors(newContributors, newMeta)
+		return newContributors, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]ContributorStats), nil
+}
+
+// prefetchOrg warms the stats/contributors cache for a newly configured org
+// right away, rather than waiting for its first request to pay the cost.
+func prefetchOrg(client *github.Client, org string) {
+	sub := logger.CreateSubLogger("stage", "prefetch", "org", org)
+
+	go func() {
+		if _, err := refreshStats(client, org); err != nil {
+			sub.Err(err).Msg("error prefetching stats")
 		}
-		cache.updateStats(stats)
 	}()
 
-	// Prefetch contributors
 	go func() {
-		contributors, err := fetchTopContributors(client, 0) // Fetch all contributors
-		if err != nil {
-			log.Printf("Error prefetching contributors: %v", err)
-			return
+		if _, err := refreshContributors(client, org); err != nil {
+			sub.Err(err).Msg("error prefetching contributors")
 		}
-		cache.updateContributors(contributors)
 	}()
 }
 
 func loadEnv() {
 	err := godotenv.Load()
 	if err != nil {
-		log.Fatal("Error loading .env file:", err)
+		sub := logger.CreateSubLogger("stage", "startup")
+		sub.Fatal().Err(err).Msg("error loading .env file")
+	}
+}
+
+// statsHandler renders the stats response for org, shared by the org-scoped
+// route and the backward-compatible default-org route.
+func statsHandler(client *github.Client, org string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stats, err := refreshStats(client, org)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Calculate totals
+		var totalStars, totalForks, totalContributors, totalCommits int
+		for _, repo := range stats {
+			totalStars += repo.Stars
+			totalForks += repo.Forks
+			totalContributors += repo.Contributors
+			totalCommits += repo.Commits
+		}
+
+		// Sort repositories by stars in descending order
+		sort.Slice(stats, func(i, j int) bool {
+			return stats[i].Stars > stats[j].Stars
+		})
+
+		c.JSON(http.StatusOK, gin.H{
+			"repositories":      stats,
+			"totalStars":        totalStars,
+			"totalForks":        totalForks,
+			"totalContributors": totalContributors,
+			"totalCommits":      totalCommits,
+			"githubUrl":         orgURL(org),
+		})
+	}
+}
+
+// contributorsHandler renders the top-contributors response for org, shared
+// by the org-scoped route and the backward-compatible default-org route.
+func contributorsHandler(client *github.Client, org string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limitStr := c.DefaultQuery("limit", "0")
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			limit = 0 // If invalid limit, show all contributors
+		}
+
+		contributors, err := refreshContributors(client, org)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Apply limit to cached data
+		if limit > 0 && limit < len(contributors) {
+			contributors = contributors[:limit]
+		}
+
+		c.JSON(http.StatusOK, contributors)
 	}
 }
 
@@ -257,11 +509,22 @@ func main() {
 
 	// Create router with trusted proxies
 	r := gin.New()
-	r.SetTrustedProxies(nil) // Trust all proxies
-	r.Use(gin.Recovery())    // Add recovery middleware
+	r.SetTrustedProxies(nil)   // Trust all proxies
+	r.Use(gin.Recovery())      // Add recovery middleware
+	r.Use(logger.Middleware()) // Attach a per-request structured logger
 
 	loadEnv()
 
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		configPath = "config.yaml"
+	}
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		sub := logger.CreateSubLogger("stage", "startup")
+		sub.Fatal().Err(err).Str("config_path", configPath).Msg("error loading config")
+	}
+
 	// Initialize GitHub client
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
@@ -269,90 +532,71 @@ func main() {
 	tc := oauth2.NewClient(context.Background(), ts)
 	client := github.NewClient(tc)
 
-	// Prefetch data on startup
-	prefetchData(client)
+	// Applying the config creates a Store per org (CACHE_BACKEND, REDIS_URL)
+	// and kicks off a prefetch for each one.
+	applyConfig(cfg, client)
+	watchConfigReload(configPath, client)
+
+	startScorecardRefresher(client)
 
 	r.GET("/", func(c *gin.Context) {
-		c.Redirect(http.StatusMovedPermanently, githubBaseURL)
+		c.Redirect(http.StatusMovedPermanently, orgURL(getDefaultOrg()))
 	})
 
-	// Repository redirection endpoint
-	r.GET("/:repo", func(c *gin.Context) {
-		repo := c.Param("repo")
+	registerWebhookRoute(r, client)
+	registerScorecardRoute(r)
 
-		// Handle special routes
-		switch repo {
-		case "stats":
-			stats, exists := cache.getStats()
-			if !exists || cache.isStale(&cache.stats) {
-				var err error
-				stats, err = fetchStats(client)
-				if err != nil {
-					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-					return
-				}
-				cache.updateStats(stats)
-			}
-
-			// Calculate totals
-			var totalStars, totalForks, totalContributors, totalCommits int
-			for _, repo := range stats {
-				totalStars += repo.Stars
-				totalForks += repo.Forks
-				totalContributors += repo.Contributors
-				totalCommits += repo.Commits
-			}
+	r.GET("/:org/stats", func(c *gin.Context) {
+		statsHandler(client, c.Param("org"))(c)
+	})
+	r.GET("/:org/contributors", func(c *gin.Context) {
+		contributorsHandler(client, c.Param("org"))(c)
+	})
 
-			// Sort repositories by stars in descending order
-			sort.Slice(stats, func(i, j int) bool {
-				return stats[i].Stars > stats[j].Stars
-			})
-
-			response := gin.H{
-				"repositories":      stats,
-				"totalStars":        totalStars,
-				"totalForks":        totalForks,
-				"totalContributors": totalContributors,
-				"totalCommits":      totalCommits,
-				"githubUrl":         githubBaseURL,
-			}
+	// Org-scoped repository redirection endpoint. gin requires every route
+	// sharing the "/:x/..." prefix to use the same wildcard name, so the
+	// single-segment-repo/scorecard legacy route from before config.yaml
+	// can't be registered separately as "/:repo/scorecard" alongside
+	// "/:org/stats" etc (that panics at startup: two different wildcard
+	// names at the same tree position). Instead, resolve it here: if the
+	// first segment isn't a configured org and the second is "scorecard",
+	// treat it as the legacy /:repo/scorecard request, scoped to
+	// default_org.
+	r.GET("/:org/:repo", func(c *gin.Context) {
+		org := c.Param("org")
+		repo := c.Param("repo")
 
-			c.JSON(http.StatusOK, response)
+		if _, _, ok := registry.get(org); !ok && repo == "scorecard" {
+			writeScorecard(c, getDefaultOrg(), org)
 			return
-		case "contributors":
-			limitStr := c.DefaultQuery("limit", "0")
-			limit, err := strconv.Atoi(limitStr)
-			if err != nil {
-				limit = 0 // If invalid limit, show all contributors
-			}
+		}
 
-			contributors, exists := cache.getContributors()
-			if !exists || cache.isStale(&cache.contributors) {
-				var err error
-				contributors, err = fetchTopContributors(client, 0) // Always fetch all for cache
-				if err != nil {
-					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-					return
-				}
-				cache.updateContributors(contributors)
-			}
+		c.Redirect(http.StatusMovedPermanently, orgURL(org)+repo)
+	})
 
-			// Apply limit to cached data
-			if limit > 0 && limit < len(contributors) {
-				contributors = contributors[:limit]
-			}
+	// Backward-compatible top-level routes, scoped to the configured
+	// default_org, so links to the single-org era of this server keep working.
+	// The segment is a repo name, but its wildcard is named ":org" to match
+	// the other routes at this tree position (see above).
+	r.GET("/:org", func(c *gin.Context) {
+		repo := c.Param("org")
+		org := getDefaultOrg()
 
-			c.JSON(http.StatusOK, contributors)
+		switch repo {
+		case "stats":
+			statsHandler(client, org)(c)
+			return
+		case "contributors":
+			contributorsHandler(client, org)(c)
 			return
 		}
 
-		// Default case: redirect to GitHub
-		redirectURL := githubBaseURL + repo
-		c.Redirect(http.StatusMovedPermanently, redirectURL)
+		c.Redirect(http.StatusMovedPermanently, orgURL(org)+repo)
 	})
 
 	// Start the server
 	if err := r.Run(":8080"); err != nil {
-		log.Fatal("Failed to start server:", err)
+		sub := logger.CreateSubLogger("stage", "startup")
+		sub.Fatal().Err(err).Msg("failed to start server")
 	}
 }
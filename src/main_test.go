@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v45/github"
+)
+
+func TestReposPath(t *testing.T) {
+	tests := []struct {
+		name string
+		org  string
+		opts *github.RepositoryListByOrgOptions
+		want string
+	}{
+		{
+			name: "first page",
+			org:  "VTubersTV",
+			opts: &github.RepositoryListByOrgOptions{Type: "all", ListOptions: github.ListOptions{PerPage: 100}},
+			want: "orgs/VTubersTV/repos?per_page=100&type=all",
+		},
+		{
+			name: "later page includes page param",
+			org:  "VTubersTV",
+			opts: &github.RepositoryListByOrgOptions{Type: "all", ListOptions: github.ListOptions{PerPage: 100, Page: 2}},
+			want: "orgs/VTubersTV/repos?page=2&per_page=100&type=all",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reposPath(tt.org, tt.opts)
+			if got != tt.want {
+				t.Errorf("reposPath(%q, %+v) = %q, want %q", tt.org, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithRateLimitBackoffRetriesThenSucceeds(t *testing.T) {
+	attempts := 0
+	resp, err := withRateLimitBackoff(func() (*github.Response, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, &github.RateLimitError{
+				Rate: github.Rate{Reset: github.Timestamp{Time: time.Now().Add(5 * time.Millisecond)}},
+			}
+		}
+		return &github.Response{}, nil
+	})
+
+	if err != nil {
+		t.Fatalf("expected eventual success, got error: %v", err)
+	}
+	if resp == nil {
+		t.Fatal("expected a non-nil response on success")
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestWithRateLimitBackoffGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	_, err := withRateLimitBackoff(func() (*github.Response, error) {
+		attempts++
+		return nil, &github.RateLimitError{
+			Rate: github.Rate{Reset: github.Timestamp{Time: time.Now().Add(5 * time.Millisecond)}},
+		}
+	})
+
+	if err == nil {
+		t.Fatal("expected an error once maxRetries is exceeded")
+	}
+	if attempts != maxRetries+1 {
+		t.Fatalf("expected %d attempts, got %d", maxRetries+1, attempts)
+	}
+}
+
+func TestWithRateLimitBackoffPassesThroughNonRateLimitErrors(t *testing.T) {
+	wantErr := errors.New("boom")
+	attempts := 0
+	_, err := withRateLimitBackoff(func() (*github.Response, error) {
+		attempts++
+		return nil, wantErr
+	})
+
+	if err != wantErr {
+		t.Fatalf("expected the underlying error to pass through unchanged, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a non-rate-limit error to not be retried, got %d attempts", attempts)
+	}
+}
+
+func TestListAllReposReusesCacheOn304(t *testing.T) {
+	const cachedETag = `"cached-etag"`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == cachedETag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		t.Fatalf("expected request to carry If-None-Match: %s, got %q", cachedETag, r.Header.Get("If-None-Match"))
+	}))
+	defer srv.Close()
+
+	client := github.NewClient(nil)
+	baseURL, err := url.Parse(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+	client.BaseURL = baseURL
+
+	repos, meta, notModified, err := listAllRepos(context.Background(), client, "VTubersTV", cachedETag)
+	if err != nil {
+		t.Fatalf("expected no error on a 304 response, got %v", err)
+	}
+	if !notModified {
+		t.Fatal("expected notModified to be true on a 304 response")
+	}
+	if repos != nil {
+		t.Fatalf("expected no repos on a 304 response, got %v", repos)
+	}
+	if meta.ETag != cachedETag {
+		t.Fatalf("expected the cached ETag to be preserved, got %q", meta.ETag)
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/google/go-github/v45/github"
+
+	"git.vtubers.tv/src/logger"
+)
+
+// orgRegistry tracks the configured orgs and each org's Store, so the
+// routes/fetch paths can go from an org name to its config and cache without
+// threading a map through every call site. Reloading config.yaml (SIGHUP)
+// diffs against this registry: new orgs get a store and a prefetch kicked
+// off, dropped orgs have their store discarded.
+type orgRegistry struct {
+	mu     sync.RWMutex
+	orgs   map[string]OrgConfig
+	stores map[string]Store
+}
+
+var registry = &orgRegistry{
+	orgs:   make(map[string]OrgConfig),
+	stores: make(map[string]Store),
+}
+
+func (r *orgRegistry) get(name string) (OrgConfig, Store, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cfg, ok := r.orgs[name]
+	if !ok {
+		return OrgConfig{}, nil, false
+	}
+	return cfg, r.stores[name], true
+}
+
+func (r *orgRegistry) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.orgs))
+	for name := range r.orgs {
+		names = append(names, name)
+	}
+	return names
+}
+
+// setOrgs replaces the configured org set, preserving stores for orgs that
+// are still present, creating stores for newly added orgs, and dropping
+// stores for removed ones. Returns the added/removed org names so the
+// caller can kick off prefetches or log the drop.
+func (r *orgRegistry) setOrgs(cfgs []OrgConfig) (added, removed []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next := make(map[string]OrgConfig, len(cfgs))
+	for _, cfg := range cfgs {
+		next[cfg.Name] = cfg
+	}
+
+	for name := range next {
+		if _, exists := r.orgs[name]; !exists {
+			added = append(added, name)
+		}
+	}
+	for name := range r.orgs {
+		if _, exists := next[name]; !exists {
+			removed = append(removed, name)
+			delete(r.stores, name)
+		}
+	}
+
+	r.orgs = next
+	for name, cfg := range next {
+		if _, exists := r.stores[name]; !exists {
+			r.stores[name] = newStore(name, cfg.cacheTTL())
+		}
+	}
+
+	return added, removed
+}
+
+var (
+	defaultOrgMu sync.RWMutex
+	defaultOrg   string
+)
+
+func setDefaultOrg(name string) {
+	defaultOrgMu.Lock()
+	defer defaultOrgMu.Unlock()
+	defaultOrg = name
+}
+
+func getDefaultOrg() string {
+	defaultOrgMu.RLock()
+	defer defaultOrgMu.RUnlock()
+	return defaultOrg
+}
+
+// applyConfig installs a freshly loaded config: it updates the default org,
+// diffs the org set, and starts a prefetch for any newly added org.
+func applyConfig(cfg *Config, client *github.Client) {
+	setDefaultOrg(cfg.DefaultOrg)
+
+	added, removed := registry.setOrgs(cfg.Orgs)
+	for _, name := range added {
+		go prefetchOrg(client, name)
+	}
+	for _, name := range removed {
+		sub := logger.CreateSubLogger("stage", "config", "org", name)
+		sub.Info().Msg("org removed from config, cache dropped")
+	}
+}
+
+// watchConfigReload reloads path on SIGHUP, so adding/removing an org in
+// config.yaml doesn't require a restart. Errors reloading are logged and
+// the previous config stays in effect.
+func watchConfigReload(path string, client *github.Client) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	go func() {
+		sub := logger.CreateSubLogger("stage", "config")
+		for range sighup {
+			cfg, err := loadConfig(path)
+			if err != nil {
+				sub.Err(err).Msg("failed to reload config on SIGHUP")
+				continue
+			}
+			applyConfig(cfg, client)
+			sub.Info().Msg("reloaded config on SIGHUP")
+		}
+	}()
+}
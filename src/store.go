@@ -0,0 +1,121 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"git.vtubers.tv/src/logger"
+)
+
+// EndpointMeta tracks the conditional-request state for a single cached
+// endpoint so refreshes can send If-None-Match instead of always paying for
+// a full fetch.
+type EndpointMeta struct {
+	ETag string
+}
+
+// Store abstracts the stats/contributors cache so it can be backed by an
+// in-process map (single replica, lost on restart) or something shared like
+// Redis (survives restarts, shared across replicas). Get calls report
+// "fresh" so callers can tell a cache hit from a cache hit that still needs
+// revalidating, without a separate staleness check.
+type Store interface {
+	GetStats() (stats []RepoStats, meta EndpointMeta, fresh bool)
+	SetStats(stats []RepoStats, meta EndpointMeta)
+	GetContributors() (contributors []ContributorStats, meta EndpointMeta, fresh bool)
+	SetContributors(contributors []ContributorStats, meta EndpointMeta)
+	Invalidate(repo string)
+}
+
+// newStore picks a Store implementation from the environment: CACHE_BACKEND
+// defaults to the in-process memoryStore, or "redis" to share state across
+// replicas via REDIS_URL. org namespaces the Redis keys so multiple orgs
+// sharing one Redis instance don't collide; ttl is the org's cache TTL.
+func newStore(org string, ttl time.Duration) Store {
+	if strings.EqualFold(os.Getenv("CACHE_BACKEND"), "redis") {
+		redisURL := os.Getenv("REDIS_URL")
+		rs, err := newRedisStore(redisURL, ttl, org)
+		if err != nil {
+			sub := logger.CreateSubLogger("stage", "store", "org", org)
+			sub.Fatal().Err(err).Str("redis_url", redisURL).Msg("failed to connect to Redis")
+		}
+		return rs
+	}
+	return newMemoryStore(ttl)
+}
+
+type cacheItem struct {
+	data      interface{}
+	timestamp time.Time
+}
+
+// memoryStore is the original in-process Store. Simple and dependency-free,
+// but state doesn't survive a restart and isn't shared between replicas.
+type memoryStore struct {
+	stats        cacheItem
+	contributors cacheItem
+
+	statsMeta        EndpointMeta
+	contributorsMeta EndpointMeta
+
+	ttl time.Duration
+	mu  sync.RWMutex
+}
+
+func newMemoryStore(ttl time.Duration) *memoryStore {
+	return &memoryStore{ttl: ttl}
+}
+
+// GetStats returns a copy of the cached slice. Callers (the stats handler
+// sorts it, webhook refreshes rebuild it) must not share a backing array
+// with what's stored here, or a concurrent Invalidate mutating its own copy
+// in place would race a caller still reading/sorting an earlier one.
+func (s *memoryStore) GetStats() ([]RepoStats, EndpointMeta, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stats, _ := s.stats.data.([]RepoStats)
+	fresh := stats != nil && time.Since(s.stats.timestamp) <= s.ttl
+	return append([]RepoStats(nil), stats...), s.statsMeta, fresh
+}
+
+func (s *memoryStore) SetStats(stats []RepoStats, meta EndpointMeta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats = cacheItem{data: append([]RepoStats(nil), stats...), timestamp: time.Now()}
+	s.statsMeta = meta
+}
+
+func (s *memoryStore) GetContributors() ([]ContributorStats, EndpointMeta, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	contributors, _ := s.contributors.data.([]ContributorStats)
+	fresh := contributors != nil && time.Since(s.contributors.timestamp) <= s.ttl
+	return append([]ContributorStats(nil), contributors...), s.contributorsMeta, fresh
+}
+
+func (s *memoryStore) SetContributors(contributors []ContributorStats, meta EndpointMeta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.contributors = cacheItem{data: append([]ContributorStats(nil), contributors...), timestamp: time.Now()}
+	s.contributorsMeta = meta
+}
+
+// Invalidate drops repo from the cached stats list. It builds a fresh slice
+// rather than splicing s.stats.data in place, so a stored backing array is
+// never modified after SetStats stores it.
+func (s *memoryStore) Invalidate(repo string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, _ := s.stats.data.([]RepoStats)
+	for i, st := range stats {
+		if st.Name == repo {
+			updated := make([]RepoStats, 0, len(stats)-1)
+			updated = append(updated, stats[:i]...)
+			updated = append(updated, stats[i+1:]...)
+			s.stats.data = updated
+			return
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"git.vtubers.tv/src/logger"
+)
+
+const redisOpTimeout = 3 * time.Second
+
+type statsEntry struct {
+	Stats []RepoStats  `json:"stats"`
+	Meta  EndpointMeta `json:"meta"`
+}
+
+type contributorsEntry struct {
+	Contributors []ContributorStats `json:"contributors"`
+	Meta         EndpointMeta       `json:"meta"`
+}
+
+// redisStore is a Store backed by Redis, so cached stats/contributors
+// survive restarts and are shared across replicas. Values are serialized as
+// JSON with a TTL matching the owning org's cache_ttl, so an expired key
+// behaves like a cache miss rather than returning stale data. Keys are
+// namespaced by org so multiple orgs sharing one Redis instance don't
+// collide.
+type redisStore struct {
+	client *redis.Client
+	ttl    time.Duration
+
+	statsKey        string
+	contributorsKey string
+}
+
+func newRedisStore(redisURL string, ttl time.Duration, org string) (*redisStore, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+
+	return &redisStore{
+		client:          client,
+		ttl:             ttl,
+		statsKey:        "git-server:" + org + ":stats",
+		contributorsKey: "git-server:" + org + ":contributors",
+	}, nil
+}
+
+func (s *redisStore) GetStats() ([]RepoStats, EndpointMeta, bool) {
+	var entry statsEntry
+	if !s.get(s.statsKey, &entry) {
+		return nil, EndpointMeta{}, false
+	}
+	return entry.Stats, entry.Meta, true
+}
+
+func (s *redisStore) SetStats(stats []RepoStats, meta EndpointMeta) {
+	s.set(s.statsKey, statsEntry{Stats: stats, Meta: meta})
+}
+
+func (s *redisStore) GetContributors() ([]ContributorStats, EndpointMeta, bool) {
+	var entry contributorsEntry
+	if !s.get(s.contributorsKey, &entry) {
+		return nil, EndpointMeta{}, false
+	}
+	return entry.Contributors, entry.Meta, true
+}
+
+func (s *redisStore) SetContributors(contributors []ContributorStats, meta EndpointMeta) {
+	s.set(s.contributorsKey, contributorsEntry{Contributors: contributors, Meta: meta})
+}
+
+// Invalidate drops a single repo from the cached stats list. Unlike
+// memoryStore, this has to round-trip the whole JSON blob since Redis has no
+// concept of "one element of this key".
+func (s *redisStore) Invalidate(repo string) {
+	stats, meta, ok := s.GetStats()
+	if !ok {
+		return
+	}
+	for i, st := range stats {
+		if st.Name == repo {
+			stats = append(stats[:i], stats[i+1:]...)
+			break
+		}
+	}
+	s.SetStats(stats, meta)
+}
+
+func (s *redisStore) get(key string, dest interface{}) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+
+	sub := logger.CreateSubLogger("stage", "store", "key", key)
+
+	raw, err := s.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			sub.Err(err).Msg("Redis GET failed")
+		}
+		return false
+	}
+
+	if err := json.Unmarshal(raw, dest); err != nil {
+		sub.Err(err).Msg("Redis decode failed")
+		return false
+	}
+	return true
+}
+
+func (s *redisStore) set(key string, value interface{}) {
+	sub := logger.CreateSubLogger("stage", "store", "key", key)
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		sub.Err(err).Msg("Redis encode failed")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisOpTimeout)
+	defer cancel()
+	if err := s.client.Set(ctx, key, raw, s.ttl).Err(); err != nil {
+		sub.Err(err).Msg("Redis SET failed")
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-github/v45/github"
+
+	"git.vtubers.tv/src/logger"
+)
+
+// registerWebhookRoute wires up POST /webhook, which lets GitHub push
+// near-real-time cache refreshes instead of waiting on the org's cache TTL
+// to expire. Only the affected repo is refetched, so a single push doesn't
+// force a full-org refetch. The refetch happens in the background and
+// swaps the cached entry in place once it completes, rather than
+// invalidating it up front, so GetStats never has a window where the repo
+// is simply missing.
+func registerWebhookRoute(r *gin.Engine, client *github.Client) {
+	r.POST("/webhook", func(c *gin.Context) {
+		payload, err := github.ValidatePayload(c.Request, []byte(os.Getenv("GITHUB_WEBHOOK_SECRET")))
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid webhook signature"})
+			return
+		}
+
+		event, err := github.ParseWebHook(github.WebHookType(c.Request), payload)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		org, repo := affectedRepo(event)
+		if repo == "" {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		if _, _, ok := registry.get(org); ok {
+			go refreshRepoStats(client, org, repo)
+		}
+
+		c.Status(http.StatusAccepted)
+	})
+}
+
+// affectedRepo extracts the org and repo name from the webhook event types
+// we care about, or ("", "") if the event doesn't map to a single repo we
+// should refresh.
+func affectedRepo(event interface{}) (org, repo string) {
+	switch e := event.(type) {
+	case *github.PushEvent:
+		return e.GetRepo().GetOwner().GetLogin(), e.GetRepo().GetName()
+	case *github.RepositoryEvent:
+		return e.GetRepo().GetOwner().GetLogin(), e.GetRepo().GetName()
+	case *github.StarEvent:
+		return e.GetRepo().GetOwner().GetLogin(), e.GetRepo().GetName()
+	case *github.ForkEvent:
+		return e.GetRepo().GetOwner().GetLogin(), e.GetRepo().GetName()
+	case *github.IssuesEvent:
+		return e.GetRepo().GetOwner().GetLogin(), e.GetRepo().GetName()
+	default:
+		return "", ""
+	}
+}
+
+// refreshRepoStats fetches fresh stats for a single repo and merges them
+// back into org's cached stats list, replacing the existing entry for repo
+// (or appending it if the repo wasn't cached yet).
+func refreshRepoStats(client *github.Client, org, repo string) {
+	_, store, ok := registry.get(org)
+	if !ok {
+		return
+	}
+
+	stats, err := fetchRepoStats(context.Background(), client, org, repo)
+	if err != nil {
+		sub := logger.CreateSubLogger("stage", "webhook", "org", org, "repo", repo)
+		sub.Err(err).Msg("refresh stats failed")
+		return
+	}
+
+	existing, meta, _ := store.GetStats()
+	updated := make([]RepoStats, 0, len(existing)+1)
+	replaced := false
+	for _, s := range existing {
+		if s.Name == repo {
+			updated = append(updated, stats)
+			replaced = true
+		} else {
+			updated = append(updated, s)
+		}
+	}
+	if !replaced {
+		updated = append(updated, stats)
+	}
+	store.SetStats(updated, meta)
+}
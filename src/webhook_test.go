@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v45/github"
+)
+
+func TestAffectedRepo(t *testing.T) {
+	owner := &github.User{Login: github.String("VTubersTV")}
+
+	tests := []struct {
+		name     string
+		event    interface{}
+		wantOrg  string
+		wantRepo string
+	}{
+		{
+			name: "push event",
+			event: &github.PushEvent{
+				Repo: &github.PushEventRepository{Name: github.String("git-server"), Owner: owner},
+			},
+			wantOrg:  "VTubersTV",
+			wantRepo: "git-server",
+		},
+		{
+			name: "repository event",
+			event: &github.RepositoryEvent{
+				Repo: &github.Repository{Name: github.String("git-server"), Owner: owner},
+			},
+			wantOrg:  "VTubersTV",
+			wantRepo: "git-server",
+		},
+		{
+			name: "star event",
+			event: &github.StarEvent{
+				Repo: &github.Repository{Name: github.String("git-server"), Owner: owner},
+			},
+			wantOrg:  "VTubersTV",
+			wantRepo: "git-server",
+		},
+		{
+			name:     "unhandled event type",
+			event:    &github.PingEvent{},
+			wantOrg:  "",
+			wantRepo: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, repo := affectedRepo(tt.event)
+			if org != tt.wantOrg || repo != tt.wantRepo {
+				t.Errorf("affectedRepo() = (%q, %q), want (%q, %q)", org, repo, tt.wantOrg, tt.wantRepo)
+			}
+		})
+	}
+}